@@ -23,6 +23,9 @@ func NewRxKey (commChan *rnet.PPO, shutChan *sync.Cond, commNet *rnet.NetCentre)
 
 // This data type should never be manipulated directly. To manipulate it, use any of its
 // faces that suit you.
+//
+// The startup and shutdown data of the key are guarded internally, so the main could use
+// its face of the key while its master uses the master face, from different goroutines.
 type RxKey struct {
 	commChan           *rnet.PPO       // The channel the key uses for communication.
 	startupResult      byte            // The startup result of the key's main,
@@ -35,6 +38,9 @@ type RxKey struct {
 		been shutdown or not. */
 	commNetCentre      *rnet.NetCentre /* The network making it possible for the main
 		to communicate with other mains in the system. */
+	stateLock          sync.RWMutex    /* The lock guarding the startup and shutdown
+		data of the key, since the main and its master access them from different
+		goroutines. */
 }
 
 
@@ -53,11 +59,15 @@ type RxKey struct {
 // outpt 1: If value of outpt 0 is SrStartupFailed, value of this data would be a text
 // describing the reason for the failure, otherwise, value would be an empty string.
 func (rxk *RxKey) StartupResult () (byte, string) {
+	rxk.stateLock.RLock ()
+	defer rxk.stateLock.RUnlock ()
 	return rxk.startupResult, rxk.startupNote
 }
 
 // ShutdownMain  () could be used to signal shutdown to the main using this key.
 func (rxk *RxKey) ShutdownMain () {
+	rxk.stateLock.Lock ()
+	defer rxk.stateLock.Unlock ()
 	rxk.shutdownSignal = true
 }
 
@@ -70,12 +80,16 @@ func (rxk *RxKey) ShutdownMain () {
 // StartupFailed () should be called if the main is unable to startup successfully. The
 // reason for startup failure should be provided as the input of this method.
 func (rxk *RxKey) StartupFailed (note string) {
+	rxk.stateLock.Lock ()
+	defer rxk.stateLock.Unlock ()
 	rxk.startupResult = SrStartupFailed
 	rxk.startupNote = note
 }
 
 // NowRunning () should be called if the main is able to startup successfully.
 func (rxk *RxKey) NowRunning () {
+	rxk.stateLock.Lock ()
+	defer rxk.stateLock.Unlock ()
 	rxk.startupResult = SrStartedUp
 	rxk.shutdownState = SsStillRunning
 }
@@ -136,6 +150,8 @@ func (rxk *RxKey) SystemShutdown () {
 // True would mean it has been asked to shutdown, while false would mean it is yet to be
 // asked to shutdown.
 func (rxk *RxKey) CheckForShutdown () (bool) {
+	rxk.stateLock.RLock ()
+	defer rxk.stateLock.RUnlock ()
 	return rxk.shutdownSignal
 }
 
@@ -144,6 +160,8 @@ func (rxk *RxKey) CheckForShutdown () (bool) {
 // be assumed to still be running, and the system may become unable to shutdown
 // gracefully.
 func (rxk *RxKey) IndicateShutdown () {
+	rxk.stateLock.Lock ()
+	defer rxk.stateLock.Unlock ()
 	rxk.shutdownState = SsHasShutdown
 }
 
@@ -156,6 +174,8 @@ func (rxk *RxKey) IndicateShutdown () {
 // ShutdownState () could be used to get the shutdown state of the main using this key.
 // Check posssible values in the variable section.
 func (rxk *RxKey) ShutdownState () (byte) {
+	rxk.stateLock.RLock ()
+	defer rxk.stateLock.RUnlock ()
 	return rxk.shutdownState
 }
 